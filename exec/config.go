@@ -0,0 +1,22 @@
+package exec
+
+import "github.com/guided-traffic/gonja/utils"
+
+// EnvironmentConfig holds per-Environment settings for the optional
+// django-style extensions registered under ext/django: the word sources
+// available to the {% lorem %} tag, and the renderer options behind the
+// {% markdown %} statement and |markdown filter. The zero value is safe
+// to use: lorem falls back to its built-in dictionaries, and markdown
+// renders with GFM and syntax-highlighted fenced code, and no raw-HTML
+// passthrough.
+type EnvironmentConfig struct {
+	// LoremDictionaries registers additional named word sources for the
+	// {% lorem %} tag and utils.LoremWithOptions, alongside the
+	// built-in "latin" and "english" dictionaries. A name here overrides
+	// a built-in dictionary of the same name.
+	LoremDictionaries map[string][]string
+
+	// Markdown configures the goldmark renderer backing {% markdown %}
+	// and |markdown. See utils.MarkdownOptions.
+	Markdown utils.MarkdownOptions
+}
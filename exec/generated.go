@@ -0,0 +1,40 @@
+package exec
+
+import (
+	"regexp"
+	"strings"
+)
+
+// generatedMarker matches the canonical "Code generated ... DO NOT EDIT."
+// line in any of the comment styles the {% generated %} statement can
+// emit ("//", "#", "<!-- -->" or "/* */").
+var generatedMarker = regexp.MustCompile(`^\s*(//|#|<!--|/\*)\s*Code generated .* DO NOT EDIT\.`)
+
+// IsGenerated reports whether src is a generated artifact: a line
+// matching the canonical "Code generated by <tool>; DO NOT EDIT." marker
+// appears before any non-comment content, following the convention
+// documented at https://pkg.go.dev/cmd/go#hdr-Generate_Go_Files_By_Processing_Source.
+func IsGenerated(src []byte) bool {
+	for _, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if generatedMarker.MatchString(line) {
+			return true
+		}
+		if !isCommentLine(trimmed) {
+			return false
+		}
+	}
+	return false
+}
+
+func isCommentLine(line string) bool {
+	switch {
+	case strings.HasPrefix(line, "//"), strings.HasPrefix(line, "#"), strings.HasPrefix(line, "<!--"), strings.HasPrefix(line, "/*"), strings.HasPrefix(line, "*"):
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,55 @@
+package exec
+
+import "testing"
+
+func TestIsGenerated(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{
+			name: "go-style marker",
+			src:  "// Code generated by gonja; DO NOT EDIT.\n\npackage foo\n",
+			want: true,
+		},
+		{
+			name: "shell-style marker",
+			src:  "#!/bin/sh\n# Code generated by gonja; DO NOT EDIT.\necho hi\n",
+			want: true,
+		},
+		{
+			name: "html-style marker",
+			src:  "<!-- Code generated by gonja; DO NOT EDIT. -->\n<html></html>\n",
+			want: true,
+		},
+		{
+			name: "c-style marker",
+			src:  "/* Code generated by gonja; DO NOT EDIT. */\nint main() {}\n",
+			want: true,
+		},
+		{
+			name: "marker after non-comment content is ignored",
+			src:  "package foo\n\n// Code generated by gonja; DO NOT EDIT.\n",
+			want: false,
+		},
+		{
+			name: "no marker at all",
+			src:  "package foo\n\nfunc main() {}\n",
+			want: false,
+		},
+		{
+			name: "similar but non-canonical comment",
+			src:  "// This file was generated, please don't touch it.\n",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsGenerated([]byte(tt.src)); got != tt.want {
+				t.Fatalf("IsGenerated(%q) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package filters
+
+import (
+	"github.com/guided-traffic/gonja/exec"
+	"github.com/guided-traffic/gonja/utils"
+)
+
+// filterDir returns "ltr" or "rtl", the bare result of
+// utils.DetectDirection, for use in expressions such as
+// `<html dir="{{ body|dir }}">`. It does not wrap its input the way the
+// {% dir %} statement does; the statement and filter live in separate
+// registries (exec.Filters vs. statements.All), so the shared name is
+// not a collision, the same way {% markdown %} and |markdown share a
+// name.
+func filterDir(e *exec.Evaluator, in *exec.Value, params *exec.VarArgs) *exec.Value {
+	return exec.AsValue(utils.DetectDirection(in.String()))
+}
+
+func init() {
+	_ = exec.Filters.Register("dir", filterDir)
+}
@@ -0,0 +1,20 @@
+package filters
+
+import (
+	"github.com/guided-traffic/gonja/exec"
+	"github.com/guided-traffic/gonja/utils"
+)
+
+// filterMarkdown renders in through utils.RenderMarkdown, configured via
+// the evaluator's exec.EnvironmentConfig.Markdown.
+func filterMarkdown(e *exec.Evaluator, in *exec.Value, params *exec.VarArgs) *exec.Value {
+	rendered, err := utils.RenderMarkdown(in.String(), e.Env.Config.Markdown)
+	if err != nil {
+		return exec.AsValue(err)
+	}
+	return exec.AsSafeValue(rendered)
+}
+
+func init() {
+	_ = exec.Filters.Register("markdown", filterMarkdown)
+}
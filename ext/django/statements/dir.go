@@ -0,0 +1,82 @@
+package statements
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/guided-traffic/gonja/exec"
+	"github.com/guided-traffic/gonja/nodes"
+	"github.com/guided-traffic/gonja/parser"
+	"github.com/guided-traffic/gonja/tokens"
+	"github.com/guided-traffic/gonja/utils"
+)
+
+// DirStmt wraps its body in a <div dir="..."> element. With no argument
+// (or an explicit "auto"), the direction is computed per-render from the
+// rendered body via utils.DetectDirection; "ltr"/"rtl" force a fixed
+// direction.
+type DirStmt struct {
+	Location *tokens.Token
+	wrapper  *nodes.Wrapper
+	dir      string // "auto", "ltr" or "rtl"
+}
+
+func (stmt *DirStmt) Position() *tokens.Token { return stmt.Location }
+func (stmt *DirStmt) String() string {
+	t := stmt.Position()
+	return fmt.Sprintf("DirStmt(Line=%d Col=%d)", t.Line, t.Col)
+}
+
+func (stmt *DirStmt) Execute(r *exec.Renderer, tag *nodes.StatementBlock) error {
+	var buf bytes.Buffer
+	sub := r.Inherit()
+	sub.Out = &buf
+
+	if err := sub.ExecuteWrapper(stmt.wrapper); err != nil {
+		return err
+	}
+
+	dir := stmt.dir
+	if dir == "auto" {
+		dir = utils.DetectDirection(buf.String())
+	}
+
+	_, _ = r.WriteString(fmt.Sprintf(`<div dir="%s">%s</div>`, dir, buf.String()))
+	return nil
+}
+
+func dirParser(p *parser.Parser, args *parser.Parser) (nodes.Statement, error) {
+	stmt := &DirStmt{
+		Location: p.Current(),
+		dir:      "auto",
+	}
+
+	if dirToken := args.Match(tokens.String); dirToken != nil {
+		switch dirToken.Val {
+		case "ltr", "rtl", "auto":
+			stmt.dir = dirToken.Val
+		default:
+			return nil, args.Error("dir-tag argument must be 'ltr', 'rtl' or 'auto'.", nil)
+		}
+	}
+
+	if !args.End() {
+		return nil, args.Error("Malformed dir-tag args.", nil)
+	}
+
+	wrapper, endArgs, err := p.WrapUntil("enddir")
+	if err != nil {
+		return nil, err
+	}
+	stmt.wrapper = wrapper
+
+	if !endArgs.End() {
+		return nil, endArgs.Error("Tag 'enddir' does not take any argument.", nil)
+	}
+
+	return stmt, nil
+}
+
+func init() {
+	_ = All.Register("dir", dirParser)
+}
@@ -0,0 +1,86 @@
+package statements
+
+import (
+	"fmt"
+
+	"github.com/guided-traffic/gonja/exec"
+	"github.com/guided-traffic/gonja/nodes"
+	"github.com/guided-traffic/gonja/parser"
+	"github.com/guided-traffic/gonja/tokens"
+)
+
+// generatedCommentStyles maps a {% generated %} style keyword to its
+// opening/closing comment delimiters. A closing delimiter of "" means the
+// marker is written as a single-line comment.
+var generatedCommentStyles = map[string][2]string{
+	"//":   {"//", ""},
+	"#":    {"#", ""},
+	"html": {"<!--", "-->"},
+	"c":    {"/*", "*/"},
+}
+
+// GeneratedStmt emits the canonical "Code generated by <tool>; DO NOT
+// EDIT." marker line, in the comment style requested by style.
+type GeneratedStmt struct {
+	Location *tokens.Token
+	tool     string
+	style    string // key into generatedCommentStyles
+}
+
+func (stmt *GeneratedStmt) Position() *tokens.Token { return stmt.Location }
+func (stmt *GeneratedStmt) String() string {
+	t := stmt.Position()
+	return fmt.Sprintf("GeneratedStmt(Line=%d Col=%d)", t.Line, t.Col)
+}
+
+func (stmt *GeneratedStmt) Execute(r *exec.Renderer, tag *nodes.StatementBlock) error {
+	delims := generatedCommentStyles[stmt.style]
+	line := fmt.Sprintf("Code generated by %s; DO NOT EDIT.", stmt.tool)
+
+	if delims[1] == "" {
+		_, _ = r.WriteString(fmt.Sprintf("%s %s\n", delims[0], line))
+	} else {
+		_, _ = r.WriteString(fmt.Sprintf("%s %s %s\n", delims[0], line, delims[1]))
+	}
+
+	return nil
+}
+
+func generatedParser(p *parser.Parser, args *parser.Parser) (nodes.Statement, error) {
+	stmt := &GeneratedStmt{
+		Location: p.Current(),
+		tool:     "gonja",
+		style:    "//",
+	}
+
+	if toolToken := args.Match(tokens.String); toolToken != nil {
+		stmt.tool = toolToken.Val
+	}
+
+	if args.MatchName("style") != nil {
+		if args.Match(tokens.Symbol, "=") == nil {
+			return nil, args.Error("Expected '=' after 'style'.", nil)
+		}
+
+		styleToken := args.Match(tokens.String)
+		if styleToken == nil {
+			return nil, args.Error("Expected a string style value.", nil)
+		}
+
+		if _, ok := generatedCommentStyles[styleToken.Val]; !ok {
+			return nil, args.Error("generated-style must be one of '//', '#', 'html' or 'c'.", nil)
+		}
+
+		stmt.style = styleToken.Val
+	}
+
+	if !args.End() {
+		return nil, args.Error("Malformed generated-tag args.", nil)
+	}
+
+	return stmt, nil
+}
+
+func init() {
+	_ = All.Register("generated", generatedParser)
+}
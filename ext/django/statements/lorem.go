@@ -14,9 +14,17 @@ import (
 
 type LoremStmt struct {
 	Location *tokens.Token
-	count    int    // number of paragraphs
-	method   string // w = words, p = HTML paragraphs, b = plain-text (default is b)
+	count    int    // number of paragraphs/sentences/words, depending on method
+	method   string // w = words, p = HTML paragraphs, b = plain-text (default), sentence = standalone sentences
 	random   bool   // does not use the default paragraph "Lorem ipsum dolor sit amet, ..."
+
+	minSentences, maxSentences int
+	minWords, maxWords         int
+
+	dictionary string // word source name, set via "dict=name"; defaults to "latin"
+
+	seed    int64
+	hasSeed bool
 }
 
 func (stmt *LoremStmt) Position() *tokens.Token { return stmt.Location }
@@ -26,7 +34,18 @@ func (stmt *LoremStmt) String() string {
 }
 
 func (stmt *LoremStmt) Execute(r *exec.Renderer, tag *nodes.StatementBlock) error {
-	lorem, err := utils.Lorem(stmt.count, stmt.method)
+	lorem, err := utils.LoremWithOptions(utils.LoremOptions{
+		Count:        stmt.count,
+		Method:       stmt.method,
+		MinSentences: stmt.minSentences,
+		MaxSentences: stmt.maxSentences,
+		MinWords:     stmt.minWords,
+		MaxWords:     stmt.maxWords,
+		Dictionary:   stmt.dictionary,
+		Dictionaries: r.Env.Config.LoremDictionaries,
+		Seed:         stmt.seed,
+		HasSeed:      stmt.hasSeed,
+	})
 	if err != nil {
 		return err
 	}
@@ -35,29 +54,103 @@ func (stmt *LoremStmt) Execute(r *exec.Renderer, tag *nodes.StatementBlock) erro
 	return nil
 }
 
+// parseLoremRange parses an optional "N" or "N-M" range following the
+// current position of args, returning (def, def) unchanged when neither
+// is present.
+func parseLoremRange(args *parser.Parser, def int) (int, int, error) {
+	minToken := args.Match(tokens.Integer)
+	if minToken == nil {
+		return def, def, nil
+	}
+
+	min := exec.AsValue(minToken.Val).Integer()
+	max := min
+
+	if args.Match(tokens.Symbol, "-") != nil {
+		maxToken := args.Match(tokens.Integer)
+		if maxToken == nil {
+			return 0, 0, args.Error("Expected an integer after '-' in lorem range.", nil)
+		}
+		max = exec.AsValue(maxToken.Val).Integer()
+	}
+
+	return min, max, nil
+}
+
 func loremParser(p *parser.Parser, args *parser.Parser) (nodes.Statement, error) {
 	stmt := &LoremStmt{
-		Location: p.Current(),
-		count:    1,
-		method:   "b",
+		Location:     p.Current(),
+		count:        1,
+		method:       "b",
+		minSentences: 3,
+		maxSentences: 3,
+		minWords:     4,
+		maxWords:     8,
+		dictionary:   "latin",
 	}
 
 	if countToken := args.Match(tokens.Integer); countToken != nil {
 		stmt.count = exec.AsValue(countToken.Val).Integer()
 	}
 
-	if methodToken := args.Match(tokens.Name); methodToken != nil {
-		if methodToken.Val != "w" && methodToken.Val != "p" && methodToken.Val != "b" {
-			return nil, args.Error("lorem-method must be either 'w', 'p' or 'b'.", nil)
+	args.MatchName("paragraphs")
+
+	if args.MatchName("sentences") != nil {
+		min, max, err := parseLoremRange(args, stmt.minSentences)
+		if err != nil {
+			return nil, err
 		}
+		stmt.minSentences, stmt.maxSentences = min, max
+	}
 
-		stmt.method = methodToken.Val
+	if args.MatchName("words") != nil {
+		min, max, err := parseLoremRange(args, stmt.minWords)
+		if err != nil {
+			return nil, err
+		}
+		stmt.minWords, stmt.maxWords = min, max
+	}
+
+	if methodToken := args.Match(tokens.Name); methodToken != nil {
+		switch methodToken.Val {
+		case "w", "p", "b", "sentence", "word":
+			stmt.method = methodToken.Val
+		default:
+			return nil, args.Error("lorem-method must be 'w', 'p', 'b', 'sentence' or 'word'.", nil)
+		}
 	}
 
 	if args.MatchName("random") != nil {
 		stmt.random = true
 	}
 
+	if args.MatchName("dict") != nil {
+		if args.Match(tokens.Symbol, "=") == nil {
+			return nil, args.Error("Expected '=' after 'dict'.", nil)
+		}
+		dictToken := args.Match(tokens.Name)
+		if dictToken == nil {
+			return nil, args.Error("Expected a dictionary name after 'dict='.", nil)
+		}
+		// Dictionaries are registered per environment (exec.
+		// EnvironmentConfig.LoremDictionaries), which isn't available
+		// at parse time, so an unknown name is only reported once the
+		// tag renders.
+		stmt.dictionary = dictToken.Val
+	}
+
+	if args.MatchName("seed") != nil {
+		if args.Match(tokens.Symbol, "=") == nil {
+			return nil, args.Error("Expected '=' after 'seed'.", nil)
+		}
+		seedToken := args.Match(tokens.Integer)
+		if seedToken == nil {
+			return nil, args.Error("Expected an integer seed value.", nil)
+		}
+		stmt.seed = int64(exec.AsValue(seedToken.Val).Integer())
+		stmt.hasSeed = true
+	}
+
 	if !args.End() {
 		return nil, args.Error("Malformed lorem-tag args.", nil)
 	}
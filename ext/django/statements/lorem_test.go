@@ -0,0 +1,77 @@
+package statements_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/guided-traffic/gonja"
+	"github.com/guided-traffic/gonja/exec"
+
+	_ "github.com/guided-traffic/gonja/ext/django/statements"
+)
+
+// render parses and executes src as a standalone template, exercising
+// loremParser end to end (tag-argument parsing through to rendering).
+func render(t *testing.T, src string) (string, error) {
+	t.Helper()
+	tpl, err := gonja.FromString(src)
+	if err != nil {
+		return "", err
+	}
+	return tpl.ExecuteToString(exec.NewContext(nil))
+}
+
+func TestLoremParserRequestExample(t *testing.T) {
+	out, err := render(t, "{% lorem 3 paragraphs sentences 4-8 words 6-12 %}")
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := len(strings.Split(strings.TrimSpace(out), "\n\n")); got != 3 {
+		t.Fatalf("expected 3 paragraphs, got %d (%q)", got, out)
+	}
+}
+
+// TestLoremParserMethodAndDictTogether guards against the method token
+// and the "dict=" keyword fighting over the same argument slot: a
+// standalone mode and a custom dictionary must be usable together.
+func TestLoremParserMethodAndDictTogether(t *testing.T) {
+	out, err := render(t, "{% lorem 2 sentence dict=english %}")
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := strings.Count(out, "."); got != 2 {
+		t.Fatalf("expected 2 sentences, got %d (%q)", got, out)
+	}
+}
+
+func TestLoremParserSeedIsReproducible(t *testing.T) {
+	first, err := render(t, "{% lorem 3 sentence seed=7 %}")
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	second, err := render(t, "{% lorem 3 sentence seed=7 %}")
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected identical output for the same seed, got %q and %q", first, second)
+	}
+}
+
+func TestLoremParserMalformedRange(t *testing.T) {
+	if _, err := render(t, "{% lorem 3 sentences 4- %}"); err == nil {
+		t.Fatal("expected a parse error for a malformed range, got nil")
+	}
+}
+
+func TestLoremParserUnknownMethod(t *testing.T) {
+	if _, err := render(t, "{% lorem 3 bogus %}"); err == nil {
+		t.Fatal("expected a parse error for an unknown method token, got nil")
+	}
+}
+
+func TestLoremParserDictRequiresEquals(t *testing.T) {
+	if _, err := render(t, "{% lorem 3 w dict english %}"); err == nil {
+		t.Fatal("expected a parse error for 'dict' without '=', got nil")
+	}
+}
@@ -0,0 +1,68 @@
+package statements
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/guided-traffic/gonja/exec"
+	"github.com/guided-traffic/gonja/nodes"
+	"github.com/guided-traffic/gonja/parser"
+	"github.com/guided-traffic/gonja/tokens"
+	"github.com/guided-traffic/gonja/utils"
+)
+
+// MarkdownStmt renders its wrapped body, then pipes the rendered output
+// through utils.RenderMarkdown, configured via the renderer's
+// exec.EnvironmentConfig.Markdown, before writing it out.
+type MarkdownStmt struct {
+	Location *tokens.Token
+	wrapper  *nodes.Wrapper
+}
+
+func (stmt *MarkdownStmt) Position() *tokens.Token { return stmt.Location }
+func (stmt *MarkdownStmt) String() string {
+	t := stmt.Position()
+	return fmt.Sprintf("MarkdownStmt(Line=%d Col=%d)", t.Line, t.Col)
+}
+
+func (stmt *MarkdownStmt) Execute(r *exec.Renderer, tag *nodes.StatementBlock) error {
+	var buf bytes.Buffer
+	sub := r.Inherit()
+	sub.Out = &buf
+
+	if err := sub.ExecuteWrapper(stmt.wrapper); err != nil {
+		return err
+	}
+
+	rendered, err := utils.RenderMarkdown(buf.String(), r.Env.Config.Markdown)
+	if err != nil {
+		return err
+	}
+
+	_, _ = r.WriteString(rendered)
+	return nil
+}
+
+func markdownParser(p *parser.Parser, args *parser.Parser) (nodes.Statement, error) {
+	stmt := &MarkdownStmt{Location: p.Current()}
+
+	if !args.End() {
+		return nil, args.Error("Tag 'markdown' does not take any argument.", nil)
+	}
+
+	wrapper, endArgs, err := p.WrapUntil("endmarkdown")
+	if err != nil {
+		return nil, err
+	}
+	stmt.wrapper = wrapper
+
+	if !endArgs.End() {
+		return nil, endArgs.Error("Tag 'endmarkdown' does not take any argument.", nil)
+	}
+
+	return stmt, nil
+}
+
+func init() {
+	_ = All.Register("markdown", markdownParser)
+}
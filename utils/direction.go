@@ -0,0 +1,58 @@
+package utils
+
+import "unicode"
+
+// Direction values returned by DetectDirection.
+const (
+	DirLTR = "ltr"
+	DirRTL = "rtl"
+)
+
+// DetectDirection applies the Unicode "first strong character" heuristic
+// (the same algorithm browsers use for dir="auto") to s: it scans runes
+// in order, classifies each as strongly left-to-right, right-to-left, or
+// neutral, and returns on the first strong rune it finds. Neutral runes
+// (digits, punctuation, formatting characters, ...) are skipped. When no
+// strongly-directional rune is found, DetectDirection defaults to "ltr".
+func DetectDirection(s string) string {
+	for _, r := range s {
+		switch bidiClassOf(r) {
+		case bidiL:
+			return DirLTR
+		case bidiR, bidiAL:
+			return DirRTL
+		}
+	}
+	return DirLTR
+}
+
+type bidiClass int
+
+const (
+	bidiNeutral bidiClass = iota
+	bidiL
+	bidiR
+	bidiAL
+)
+
+// bidiClassOf classifies r into the strong bidi categories relevant to
+// the first-strong heuristic: L (left-to-right letters), R (right-to-left
+// letters such as Hebrew) or AL (Arabic-letter). Everything else,
+// including digits and formatting characters, is reported as neutral.
+// Right-to-left letters are recognised by script only: Arabic, Hebrew,
+// Syriac, Thaana and NKo. Letters of any other script, including other
+// right-to-left scripts such as Samaritan or Adlam, are reported as L.
+func bidiClassOf(r rune) bidiClass {
+	switch {
+	case !unicode.IsLetter(r):
+		return bidiNeutral
+	case unicode.Is(unicode.Arabic, r):
+		return bidiAL
+	case unicode.Is(unicode.Hebrew, r), unicode.Is(unicode.Syriac, r), unicode.Is(unicode.Thaana, r), unicode.Is(unicode.Nko, r):
+		return bidiR
+	case unicode.IsLetter(r):
+		return bidiL
+	default:
+		return bidiNeutral
+	}
+}
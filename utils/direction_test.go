@@ -0,0 +1,27 @@
+package utils
+
+import "testing"
+
+func TestDetectDirection(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty string defaults to ltr", "", DirLTR},
+		{"digits and punctuation only default to ltr", "123 !?.,", DirLTR},
+		{"latin letters are ltr", "Hello, world", DirLTR},
+		{"hebrew letters are rtl", "שלום עולם", DirRTL},
+		{"arabic letters are rtl", "مرحبا بالعالم", DirRTL},
+		{"neutral prefix is skipped", "123 שלום", DirRTL},
+		{"first strong rune wins over a later opposite one", "Hello שלום", DirLTR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectDirection(tt.in); got != tt.want {
+				t.Fatalf("DetectDirection(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
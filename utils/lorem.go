@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// LoremOptions configures a single call to LoremWithOptions. Method is one
+// of "w"/"word" (count words), "sentence" (count sentences), "p" (count
+// HTML paragraphs) or "b"/anything else (count plain-text paragraphs,
+// the default). Sentence and word counts are drawn uniformly from
+// [MinSentences, MaxSentences] and [MinWords, MaxWords]; set Min == Max
+// for a fixed count. HasSeed makes generation reproducible across calls.
+type LoremOptions struct {
+	Count        int
+	Method       string
+	MinSentences int
+	MaxSentences int
+	MinWords     int
+	MaxWords     int
+	Dictionary   string
+	Seed         int64
+	HasSeed      bool
+
+	// Dictionaries supplies additional named word sources, keyed the
+	// same way as Dictionary. Callers thread their environment's
+	// registered dictionaries (exec.EnvironmentConfig.LoremDictionaries)
+	// through here instead of mutating shared package state; a name
+	// present here overrides a built-in dictionary of the same name.
+	Dictionaries map[string][]string
+}
+
+var latinWords = strings.Fields(`lorem ipsum dolor sit amet consectetur adipiscing elit sed do
+	eiusmod tempor incididunt ut labore et dolore magna aliqua enim ad minim
+	veniam quis nostrud exercitation ullamco laboris nisi aliquip ex ea
+	commodo consequat duis aute irure in reprehenderit voluptate velit esse
+	cillum fugiat nulla pariatur excepteur sint occaecat cupidatat non
+	proident sunt culpa qui officia deserunt mollit anim id est laborum`)
+
+var englishWords = strings.Fields(`the quick brown fox jumps over lazy dog while chasing clouds
+	across morning sky summer rain falls softly city lights shine bright
+	river flows gently toward distant mountains people gather share stories
+	under starlit nights children laugh play fields golden wheat sway breeze`)
+
+// defaultLoremDictionaries are the built-in word sources available to
+// every environment. Additional or overriding sources are supplied per
+// call via LoremOptions.Dictionaries (see
+// exec.EnvironmentConfig.LoremDictionaries) rather than registered here,
+// so this map is never mutated after init and needs no synchronization.
+var defaultLoremDictionaries = map[string][]string{
+	"latin":   latinWords,
+	"english": englishWords,
+}
+
+// resolveLoremDictionary looks up name in custom first, then in the
+// built-in dictionaries, reporting whether either held it.
+func resolveLoremDictionary(custom map[string][]string, name string) ([]string, bool) {
+	if words, ok := custom[name]; ok {
+		return words, true
+	}
+	words, ok := defaultLoremDictionaries[name]
+	return words, ok
+}
+
+// Lorem generates count paragraphs of lorem-ipsum-style filler text.
+// method is "w" for a flat list of words, "p" for HTML paragraphs, or
+// anything else (including the default "b") for plain-text paragraphs.
+// It is kept for backwards compatibility; LoremWithOptions exposes
+// sentence/word ranges, dictionaries and seeding.
+func Lorem(count int, method string) (string, error) {
+	return LoremWithOptions(LoremOptions{
+		Count:        count,
+		Method:       method,
+		MinSentences: 3,
+		MaxSentences: 3,
+		MinWords:     4,
+		MaxWords:     8,
+		Dictionary:   "latin",
+	})
+}
+
+// LoremWithOptions generates lorem-ipsum-style filler text according to
+// opts. See LoremOptions for the supported methods.
+func LoremWithOptions(opts LoremOptions) (string, error) {
+	if opts.Count <= 0 {
+		return "", fmt.Errorf("lorem: count must be positive, got %d", opts.Count)
+	}
+
+	dictionary := opts.Dictionary
+	if dictionary == "" {
+		dictionary = "latin"
+	}
+
+	words, ok := resolveLoremDictionary(opts.Dictionaries, dictionary)
+	if !ok {
+		return "", fmt.Errorf("lorem: unknown dictionary %q", dictionary)
+	}
+
+	if opts.MinWords <= 0 || opts.MaxWords <= 0 {
+		return "", fmt.Errorf("lorem: word count must be positive, got %d-%d", opts.MinWords, opts.MaxWords)
+	}
+	if opts.Method != "w" && opts.Method != "word" && (opts.MinSentences <= 0 || opts.MaxSentences <= 0) {
+		return "", fmt.Errorf("lorem: sentence count must be positive, got %d-%d", opts.MinSentences, opts.MaxSentences)
+	}
+
+	rng := rand.New(rand.NewSource(rand.Int63()))
+	if opts.HasSeed {
+		rng = rand.New(rand.NewSource(opts.Seed))
+	}
+
+	switch opts.Method {
+	case "w", "word":
+		return strings.Join(loremWords(rng, words, opts.Count), " "), nil
+	case "sentence":
+		sentences := make([]string, opts.Count)
+		for i := range sentences {
+			sentences[i] = loremSentence(rng, words, opts.MinWords, opts.MaxWords)
+		}
+		return strings.Join(sentences, " "), nil
+	case "p":
+		paragraphs := make([]string, opts.Count)
+		for i := range paragraphs {
+			paragraphs[i] = "<p>" + loremParagraph(rng, words, opts.MinSentences, opts.MaxSentences, opts.MinWords, opts.MaxWords) + "</p>"
+		}
+		return strings.Join(paragraphs, "\n"), nil
+	default:
+		paragraphs := make([]string, opts.Count)
+		for i := range paragraphs {
+			paragraphs[i] = loremParagraph(rng, words, opts.MinSentences, opts.MaxSentences, opts.MinWords, opts.MaxWords)
+		}
+		return strings.Join(paragraphs, "\n\n"), nil
+	}
+}
+
+func loremWords(rng *rand.Rand, dict []string, n int) []string {
+	out := make([]string, n)
+	for i := range out {
+		out[i] = dict[rng.Intn(len(dict))]
+	}
+	return out
+}
+
+func loremSentence(rng *rand.Rand, dict []string, minWords, maxWords int) string {
+	n := minWords
+	if maxWords > minWords {
+		n += rng.Intn(maxWords - minWords + 1)
+	}
+
+	sentence := strings.Join(loremWords(rng, dict, n), " ")
+	return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+}
+
+func loremParagraph(rng *rand.Rand, dict []string, minSentences, maxSentences, minWords, maxWords int) string {
+	n := minSentences
+	if maxSentences > minSentences {
+		n += rng.Intn(maxSentences - minSentences + 1)
+	}
+
+	sentences := make([]string, n)
+	for i := range sentences {
+		sentences[i] = loremSentence(rng, dict, minWords, maxWords)
+	}
+	return strings.Join(sentences, " ")
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoremWithOptionsMethods(t *testing.T) {
+	base := LoremOptions{
+		Count:        2,
+		MinSentences: 3,
+		MaxSentences: 3,
+		MinWords:     4,
+		MaxWords:     4,
+		Dictionary:   "latin",
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		want   func(t *testing.T, out string)
+	}{
+		{
+			name:   "words",
+			method: "w",
+			want: func(t *testing.T, out string) {
+				if got := len(strings.Fields(out)); got != base.Count {
+					t.Fatalf("expected %d words, got %d (%q)", base.Count, got, out)
+				}
+			},
+		},
+		{
+			name:   "sentences",
+			method: "sentence",
+			want: func(t *testing.T, out string) {
+				if got := strings.Count(out, "."); got != base.Count {
+					t.Fatalf("expected %d sentences, got %d (%q)", base.Count, got, out)
+				}
+			},
+		},
+		{
+			name:   "html paragraphs",
+			method: "p",
+			want: func(t *testing.T, out string) {
+				if got := strings.Count(out, "<p>"); got != base.Count {
+					t.Fatalf("expected %d <p> paragraphs, got %d (%q)", base.Count, got, out)
+				}
+			},
+		},
+		{
+			name:   "plain paragraphs",
+			method: "b",
+			want: func(t *testing.T, out string) {
+				if got := len(strings.Split(out, "\n\n")); got != base.Count {
+					t.Fatalf("expected %d paragraphs, got %d (%q)", base.Count, got, out)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := base
+			opts.Method = tt.method
+			out, err := LoremWithOptions(opts)
+			if err != nil {
+				t.Fatalf("LoremWithOptions: %v", err)
+			}
+			tt.want(t, out)
+		})
+	}
+}
+
+func TestLoremWithOptionsSeedIsReproducible(t *testing.T) {
+	opts := LoremOptions{
+		Count: 3, Method: "sentence",
+		MinSentences: 1, MaxSentences: 1,
+		MinWords: 3, MaxWords: 10,
+		Dictionary: "english",
+		Seed:       42, HasSeed: true,
+	}
+
+	first, err := LoremWithOptions(opts)
+	if err != nil {
+		t.Fatalf("LoremWithOptions: %v", err)
+	}
+	second, err := LoremWithOptions(opts)
+	if err != nil {
+		t.Fatalf("LoremWithOptions: %v", err)
+	}
+	if first != second {
+		t.Fatalf("expected identical output for the same seed, got %q and %q", first, second)
+	}
+}
+
+func TestLoremWithOptionsCustomDictionary(t *testing.T) {
+	opts := LoremOptions{
+		Count: 5, Method: "w",
+		MinWords: 1, MaxWords: 1,
+		Dictionary:   "custom",
+		Dictionaries: map[string][]string{"custom": {"foo"}},
+	}
+
+	out, err := LoremWithOptions(opts)
+	if err != nil {
+		t.Fatalf("LoremWithOptions: %v", err)
+	}
+	for _, word := range strings.Fields(out) {
+		if word != "foo" {
+			t.Fatalf("expected every word to come from the custom dictionary, got %q in %q", word, out)
+		}
+	}
+}
+
+func TestLoremWithOptionsCustomDictionaryOverridesBuiltin(t *testing.T) {
+	opts := LoremOptions{
+		Count: 1, Method: "w",
+		MinWords: 1, MaxWords: 1,
+		Dictionary:   "latin",
+		Dictionaries: map[string][]string{"latin": {"override"}},
+	}
+
+	out, err := LoremWithOptions(opts)
+	if err != nil {
+		t.Fatalf("LoremWithOptions: %v", err)
+	}
+	if out != "override" {
+		t.Fatalf("expected the custom dictionary to override the built-in one, got %q", out)
+	}
+}
+
+func TestLoremWithOptionsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts LoremOptions
+	}{
+		{"zero count", LoremOptions{Count: 0, MinWords: 1, MaxWords: 1, Dictionary: "latin"}},
+		{"unknown dictionary", LoremOptions{Count: 1, MinWords: 1, MaxWords: 1, Dictionary: "klingon"}},
+		{"zero word range", LoremOptions{Count: 1, Dictionary: "latin"}},
+		{"zero sentence range", LoremOptions{Count: 1, MinWords: 1, MaxWords: 1, Dictionary: "latin"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoremWithOptions(tt.opts); err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// MarkdownOptions configures RenderMarkdown's goldmark instance. The zero
+// value renders with GFM (tables, strikethrough, autolinks, linkify) and
+// syntax-highlighted fenced code, but no raw-HTML passthrough, heading
+// anchors or link rewriting.
+type MarkdownOptions struct {
+	// UnsafeHTML allows raw HTML in the source to pass through unescaped,
+	// matching goldmark's html.WithUnsafe renderer option. Defaults to
+	// false: raw HTML is stripped from the output.
+	UnsafeHTML bool
+	// Extensions are additional goldmark.Extender instances layered on
+	// top of the default GFM and syntax-highlighting extensions, e.g.
+	// extension.Footnote.
+	Extensions []goldmark.Extender
+	// HeadingAnchors, when true, assigns a stable id attribute to every
+	// heading so rendered documents can be deep-linked.
+	HeadingAnchors bool
+	// HeadingAnchorFunc overrides the default slug generator used when
+	// HeadingAnchors is set. It receives the heading's plain text and
+	// returns the id to assign; collisions are disambiguated
+	// automatically. Ignored when HeadingAnchors is false.
+	HeadingAnchorFunc func(headingText string) string
+	// ResolveLink, when set, rewrites link and image destinations before
+	// they are written out, e.g. to resolve relative paths against a
+	// docs root or rewrite them to a CDN.
+	ResolveLink func(destination string) string
+}
+
+// RenderMarkdown converts source Markdown to HTML using goldmark.
+func RenderMarkdown(source string, opts MarkdownOptions) (string, error) {
+	extensions := []goldmark.Extender{extension.GFM, highlighting.Highlighting}
+
+	if opts.HeadingAnchors {
+		anchorFunc := opts.HeadingAnchorFunc
+		if anchorFunc == nil {
+			anchorFunc = defaultHeadingAnchor
+		}
+		extensions = append(extensions, &headingAnchorExtension{anchorFunc: anchorFunc})
+	}
+
+	if opts.ResolveLink != nil {
+		extensions = append(extensions, &linkResolverExtension{resolve: opts.ResolveLink})
+	}
+
+	extensions = append(extensions, opts.Extensions...)
+
+	var rendererOpts []renderer.Option
+	if opts.UnsafeHTML {
+		rendererOpts = append(rendererOpts, html.WithUnsafe())
+	}
+
+	md := goldmark.New(
+		goldmark.WithExtensions(extensions...),
+		goldmark.WithRendererOptions(rendererOpts...),
+	)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// headingAnchorExtension assigns an id attribute to every heading via
+// anchorFunc, so rendered headings can be linked to directly.
+type headingAnchorExtension struct {
+	anchorFunc func(string) string
+}
+
+func (e *headingAnchorExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(&headingAnchorTransformer{anchorFunc: e.anchorFunc}, 100),
+	))
+}
+
+type headingAnchorTransformer struct {
+	anchorFunc func(string) string
+}
+
+func (t *headingAnchorTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	used := map[string]int{}
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+
+		id := t.anchorFunc(headingText(heading, reader.Source()))
+		if count, seen := used[id]; seen {
+			used[id] = count + 1
+			id = id + "-" + strconv.Itoa(count+1)
+		} else {
+			used[id] = 0
+		}
+		heading.SetAttributeString("id", []byte(id))
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// headingText collects the plain text of a heading's inline children,
+// ignoring any inline markup (emphasis, links, ...).
+func headingText(n ast.Node, source []byte) string {
+	var sb strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		if t, ok := c.(*ast.Text); ok {
+			sb.Write(t.Segment.Value(source))
+			continue
+		}
+		sb.WriteString(headingText(c, source))
+	}
+	return sb.String()
+}
+
+// defaultHeadingAnchor lowercases text and replaces runs of
+// non-alphanumeric characters with a single hyphen, trimming leading and
+// trailing hyphens.
+func defaultHeadingAnchor(headingText string) string {
+	var sb strings.Builder
+	lastHyphen := true
+	for _, r := range strings.ToLower(headingText) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+			lastHyphen = false
+			continue
+		}
+		if !lastHyphen {
+			sb.WriteByte('-')
+			lastHyphen = true
+		}
+	}
+	return strings.Trim(sb.String(), "-")
+}
+
+// linkResolverExtension rewrites link and image destinations via
+// resolve, e.g. to turn relative paths into absolute URLs.
+type linkResolverExtension struct {
+	resolve func(string) string
+}
+
+func (e *linkResolverExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(&linkResolverTransformer{resolve: e.resolve}, 200),
+	))
+}
+
+type linkResolverTransformer struct {
+	resolve func(string) string
+}
+
+func (t *linkResolverTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch link := n.(type) {
+		case *ast.Link:
+			link.Destination = []byte(t.resolve(string(link.Destination)))
+		case *ast.Image:
+			link.Destination = []byte(t.resolve(string(link.Destination)))
+		}
+		return ast.WalkContinue, nil
+	})
+}
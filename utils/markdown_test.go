@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdownDefaultsStripRawHTML(t *testing.T) {
+	out, err := RenderMarkdown("<b>bold</b> text", MarkdownOptions{})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if got := out; strings.Contains(got, "<b>") {
+		t.Fatalf("expected raw HTML to be stripped by default, got %q", got)
+	}
+}
+
+func TestRenderMarkdownUnsafeHTMLPassesThrough(t *testing.T) {
+	out, err := RenderMarkdown("<b>bold</b> text", MarkdownOptions{UnsafeHTML: true})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if !strings.Contains(out, "<b>bold</b>") {
+		t.Fatalf("expected raw HTML to pass through, got %q", out)
+	}
+}
+
+func TestRenderMarkdownGFMTable(t *testing.T) {
+	out, err := RenderMarkdown("| a | b |\n| - | - |\n| 1 | 2 |\n", MarkdownOptions{})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if !strings.Contains(out, "<table>") {
+		t.Fatalf("expected GFM table rendering, got %q", out)
+	}
+}
+
+func TestRenderMarkdownHeadingAnchors(t *testing.T) {
+	out, err := RenderMarkdown("# Hello World\n\n# Hello World\n", MarkdownOptions{HeadingAnchors: true})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if !strings.Contains(out, `id="hello-world"`) {
+		t.Fatalf("expected a slugified heading id, got %q", out)
+	}
+	if !strings.Contains(out, `id="hello-world-1"`) {
+		t.Fatalf("expected the second duplicate heading id to be disambiguated, got %q", out)
+	}
+}
+
+func TestRenderMarkdownHeadingAnchorFuncOverride(t *testing.T) {
+	out, err := RenderMarkdown("# Hello World\n", MarkdownOptions{
+		HeadingAnchors:    true,
+		HeadingAnchorFunc: func(string) string { return "custom-id" },
+	})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if !strings.Contains(out, `id="custom-id"`) {
+		t.Fatalf("expected the custom anchor func to be used, got %q", out)
+	}
+}
+
+func TestRenderMarkdownResolveLink(t *testing.T) {
+	out, err := RenderMarkdown("[docs](./guide.md)\n", MarkdownOptions{
+		ResolveLink: func(dest string) string { return "https://example.com/" + dest },
+	})
+	if err != nil {
+		t.Fatalf("RenderMarkdown: %v", err)
+	}
+	if !strings.Contains(out, `href="https://example.com/./guide.md"`) {
+		t.Fatalf("expected the link destination to be resolved, got %q", out)
+	}
+}